@@ -0,0 +1,55 @@
+// Command tracker is the SDE2 study-tracker CLI: log study sessions, track
+// per-topic progress, and render summaries, plus a "hello" subcommand kept
+// for backward compatibility with the original greeter example.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/hellocmd"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/logcmd"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/progresscmd"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/reportcmd"
+)
+
+const usage = `Usage: tracker <subcommand> [flags]
+
+Subcommands:
+  hello     print a greeting (kept for backward compatibility)
+  log       record a study session
+  progress  show per-topic streaks and weekly totals
+  report    render a full Markdown or JSON study report
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	sub, args := os.Args[1], os.Args[2:]
+
+	var err error
+	switch sub {
+	case "hello":
+		err = hellocmd.Run(args, os.Stdout)
+	case "log":
+		err = logcmd.Run(args, os.Stdout)
+	case "progress":
+		err = progresscmd.Run(args, os.Stdout)
+	case "report":
+		err = reportcmd.Run(args, os.Stdout)
+	case "-h", "--help", "help":
+		fmt.Fprint(os.Stdout, usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "tracker: unknown subcommand %q\n\n%s", sub, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "tracker:", err)
+		os.Exit(1)
+	}
+}
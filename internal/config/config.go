@@ -0,0 +1,68 @@
+// Package config resolves the settings shared by every tracker subcommand:
+// which user's data to operate on, which language to greet them in, and
+// where their data lives on disk.
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultLang is used when no language is specified via flag or LANG.
+const DefaultLang = "en"
+
+// dataDirEnvVar overrides where session data is stored, mainly for tests.
+const dataDirEnvVar = "STUDY_TRACKER_HOME"
+
+// ResolveUser picks the current user using the following precedence:
+//  1. userFlag, if non-empty
+//  2. the USER, LOGNAME, then USERNAME environment variables, in that order
+//  3. "anonymous" if none of the above are set
+func ResolveUser(userFlag string) string {
+	if userFlag != "" {
+		return userFlag
+	}
+	for _, envVar := range []string{"USER", "LOGNAME", "USERNAME"} {
+		if v := os.Getenv(envVar); v != "" {
+			return v
+		}
+	}
+	return "anonymous"
+}
+
+// ResolveLang picks the greeting language using langFlag, falling back to
+// the LANG environment variable (trimmed to its leading locale component,
+// e.g. "fr_FR.UTF-8" -> "fr"), then DefaultLang.
+func ResolveLang(langFlag string) string {
+	if langFlag != "" {
+		return langFlag
+	}
+	if v := os.Getenv("LANG"); v != "" {
+		code := strings.SplitN(v, "_", 2)[0]
+		code = strings.SplitN(code, ".", 2)[0]
+		if code != "" {
+			return code
+		}
+	}
+	return DefaultLang
+}
+
+// DataDir returns the directory the tracker stores its data in: the
+// STUDY_TRACKER_HOME environment variable if set, otherwise
+// "<home>/.study-tracker".
+func DataDir() (string, error) {
+	if v := os.Getenv(dataDirEnvVar); v != "" {
+		return v, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".study-tracker"), nil
+}
+
+// SessionsPath returns the path to a user's session store within dataDir.
+func SessionsPath(dataDir, user string) string {
+	return filepath.Join(dataDir, user+"-sessions.json")
+}
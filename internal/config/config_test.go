@@ -0,0 +1,72 @@
+package config
+
+import "testing"
+
+func TestResolveUser(t *testing.T) {
+	cases := []struct {
+		name     string
+		userFlag string
+		env      map[string]string
+		want     string
+	}{
+		{name: "flag wins over everything", userFlag: "ada", env: map[string]string{"USER": "root"}, want: "ada"},
+		{name: "USER wins over LOGNAME and USERNAME", env: map[string]string{"USER": "alice", "LOGNAME": "bob", "USERNAME": "carol"}, want: "alice"},
+		{name: "LOGNAME used when USER unset", env: map[string]string{"LOGNAME": "bob", "USERNAME": "carol"}, want: "bob"},
+		{name: "USERNAME used when USER and LOGNAME unset", env: map[string]string{"USERNAME": "carol"}, want: "carol"},
+		{name: "falls back to anonymous", want: "anonymous"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, envVar := range []string{"USER", "LOGNAME", "USERNAME"} {
+				t.Setenv(envVar, c.env[envVar])
+			}
+			got := ResolveUser(c.userFlag)
+			if got != c.want {
+				t.Errorf("ResolveUser(%q) = %q, want %q", c.userFlag, got, c.want)
+			}
+		})
+	}
+}
+
+func TestResolveLang(t *testing.T) {
+	cases := []struct {
+		name     string
+		langFlag string
+		env      string
+		want     string
+	}{
+		{name: "flag wins over LANG", langFlag: "fr", env: "de_DE.UTF-8", want: "fr"},
+		{name: "LANG env is parsed to its locale prefix", env: "es_ES.UTF-8", want: "es"},
+		{name: "falls back to default", want: DefaultLang},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("LANG", c.env)
+			got := ResolveLang(c.langFlag)
+			if got != c.want {
+				t.Errorf("ResolveLang(%q) with LANG=%q = %q, want %q", c.langFlag, c.env, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDataDir(t *testing.T) {
+	t.Setenv("STUDY_TRACKER_HOME", "/tmp/example-home")
+	got, err := DataDir()
+	if err != nil {
+		t.Fatalf("DataDir() unexpected error: %v", err)
+	}
+	if got != "/tmp/example-home" {
+		t.Errorf("DataDir() = %q, want %q", got, "/tmp/example-home")
+	}
+}
+
+func TestSessionsPath(t *testing.T) {
+	got := SessionsPath("/tmp/example-home", "ada")
+	want := "/tmp/example-home/ada-sessions.json"
+	if got != want {
+		t.Errorf("SessionsPath() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+// Package hellocmd implements the tracker binary's "hello" subcommand,
+// preserving the standalone greeter's behavior for backward compatibility.
+package hellocmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/config"
+	"github.com/abhishek-8285/sde2-study-tracker/learning/go-learning/week01/practice/greet"
+)
+
+type result struct {
+	Name    string `json:"name"`
+	Lang    string `json:"lang"`
+	Message string `json:"message"`
+}
+
+// Run parses args and writes a greeting to w.
+func Run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("hello", flag.ContinueOnError)
+	name := fs.String("name", "", "name to greet")
+	lang := fs.String("lang", "", "language code for the greeting (en, es, fr, de, hi, ja)")
+	format := fs.String("format", "text", "output format: text, json, or yaml")
+	repeat := fs.Int("repeat", 1, "number of times to repeat the greeting")
+	externalCmd := fs.String("external-cmd", "", "path to an external program to render the greeting; the name is passed on its stdin")
+	externalArgs := fs.String("external-args", "", "comma-separated argv to pass to --external-cmd")
+	externalTimeout := fs.Duration("external-timeout", greet.DefaultTimeout, "timeout for --external-cmd")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	resolvedName := *name
+	if resolvedName == "" && fs.NArg() > 0 {
+		resolvedName = fs.Arg(0)
+	}
+	if resolvedName == "" {
+		for _, envVar := range []string{"USER", "LOGNAME", "USERNAME"} {
+			if v := os.Getenv(envVar); v != "" {
+				resolvedName = v
+				break
+			}
+		}
+	}
+	if resolvedName == "" {
+		resolvedName = "World"
+	}
+	resolvedLang := config.ResolveLang(*lang)
+
+	if *repeat < 1 {
+		return fmt.Errorf("--repeat must be at least 1, got %d", *repeat)
+	}
+
+	g := newGreeter(resolvedLang, *externalCmd, *externalArgs, *externalTimeout)
+	msg, err := g.Greet(context.Background(), resolvedName)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "text":
+		for i := 0; i < *repeat; i++ {
+			fmt.Fprintln(w, msg)
+		}
+	case "json":
+		enc := json.NewEncoder(w)
+		for i := 0; i < *repeat; i++ {
+			if err := enc.Encode(result{Name: resolvedName, Lang: resolvedLang, Message: msg}); err != nil {
+				return err
+			}
+		}
+	case "yaml":
+		for i := 0; i < *repeat; i++ {
+			fmt.Fprintf(w, "name: %s\nlang: %s\nmessage: %s\n---\n", resolvedName, resolvedLang, msg)
+		}
+	default:
+		return fmt.Errorf("unknown format %q (want text, json, or yaml)", *format)
+	}
+	return nil
+}
+
+// newGreeter builds a greet.Greeter: an ExternalGreeter when externalCmd is
+// set, or a LocalGreeter for lang otherwise.
+func newGreeter(lang, externalCmd, externalArgs string, timeout time.Duration) greet.Greeter {
+	if externalCmd == "" {
+		return greet.NewLocalGreeter(lang)
+	}
+	var args []string
+	if externalArgs != "" {
+		args = strings.Split(externalArgs, ",")
+	}
+	return &greet.ExternalGreeter{Path: externalCmd, Args: args, Timeout: timeout}
+}
@@ -0,0 +1,83 @@
+package hellocmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRunFormats(t *testing.T) {
+	cases := []struct {
+		name       string
+		args       []string
+		wantErr    bool
+		wantSubstr string
+	}{
+		{name: "text format", args: []string{"--name=Ada", "--lang=fr", "--format=text"}, wantSubstr: "Bonjour, Ada!"},
+		{name: "json format", args: []string{"--name=Ada", "--lang=fr", "--format=json"}, wantSubstr: `"message":"Bonjour, Ada!"`},
+		{name: "yaml format", args: []string{"--name=Ada", "--lang=de", "--format=yaml"}, wantSubstr: "message: Hallo, Ada!"},
+		{name: "unknown format errors", args: []string{"--format=xml"}, wantErr: true},
+		{name: "non-positive repeat errors", args: []string{"--repeat=0"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Run(c.args, &buf)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Run(%v) = nil error, want an error", c.args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run(%v) unexpected error: %v", c.args, err)
+			}
+			if !strings.Contains(buf.String(), c.wantSubstr) {
+				t.Errorf("Run(%v) output %q does not contain %q", c.args, buf.String(), c.wantSubstr)
+			}
+		})
+	}
+}
+
+func TestRunNameFallback(t *testing.T) {
+	t.Setenv("USER", "")
+	t.Setenv("LOGNAME", "")
+	t.Setenv("USERNAME", "")
+
+	var buf bytes.Buffer
+	if err := Run(nil, &buf); err != nil {
+		t.Fatalf("Run(nil) unexpected error: %v", err)
+	}
+	want := "Hello, World!\n"
+	if buf.String() != want {
+		t.Errorf("Run(nil) = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRunPositionalArgWinsOverEnv(t *testing.T) {
+	t.Setenv("USER", "root")
+
+	var buf bytes.Buffer
+	if err := Run([]string{"Grace"}, &buf); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Grace") {
+		t.Errorf("Run() = %q, want it to greet the positional arg", buf.String())
+	}
+}
+
+func TestRunExternalCmd(t *testing.T) {
+	var buf bytes.Buffer
+	err := Run([]string{"--external-cmd=/definitely/not/a/real/binary"}, &buf)
+	if err == nil {
+		t.Fatal("Run() with a bogus --external-cmd = nil error, want one")
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Run([]string{"-h"}, &buf); err != nil {
+		t.Fatalf("Run([-h]) = %v, want nil error", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Package logcmd implements the tracker binary's "log" subcommand, which
+// appends a study session to the current user's session store.
+package logcmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/config"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/store"
+)
+
+// Run parses args, appends the described session to disk, and writes a
+// confirmation to w.
+func Run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("log", flag.ContinueOnError)
+	user := fs.String("user", "", "user to log the session for")
+	topic := fs.String("topic", "", "topic studied (required)")
+	minutes := fs.Int("minutes", 0, "minutes spent studying (required, must be positive)")
+	notes := fs.String("notes", "", "freeform notes about the session")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if *topic == "" {
+		return fmt.Errorf("--topic is required")
+	}
+	if *minutes <= 0 {
+		return fmt.Errorf("--minutes must be positive, got %d", *minutes)
+	}
+
+	dataDir, err := config.DataDir()
+	if err != nil {
+		return fmt.Errorf("logcmd: resolving data directory: %w", err)
+	}
+	resolvedUser := config.ResolveUser(*user)
+	path := config.SessionsPath(dataDir, resolvedUser)
+
+	session := store.Session{
+		Topic:    *topic,
+		Minutes:  *minutes,
+		Notes:    *notes,
+		LoggedAt: time.Now(),
+	}
+	if err := store.Append(path, session); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Logged %d minutes on %q for %s\n", session.Minutes, session.Topic, resolvedUser)
+	return nil
+}
@@ -0,0 +1,72 @@
+package logcmd
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/store"
+)
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		name       string
+		args       []string
+		wantErr    bool
+		wantSubstr string
+	}{
+		{name: "logs a session", args: []string{"--user=ada", "--topic=go", "--minutes=30", "--notes=channels"}, wantSubstr: `Logged 30 minutes on "go" for ada`},
+		{name: "missing topic errors", args: []string{"--user=ada", "--minutes=30"}, wantErr: true},
+		{name: "non-positive minutes errors", args: []string{"--user=ada", "--topic=go", "--minutes=0"}, wantErr: true},
+		{name: "negative minutes errors", args: []string{"--user=ada", "--topic=go", "--minutes=-5"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("STUDY_TRACKER_HOME", t.TempDir())
+			var buf bytes.Buffer
+			err := Run(c.args, &buf)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Run(%v) = nil error, want an error", c.args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run(%v) unexpected error: %v", c.args, err)
+			}
+			if !strings.Contains(buf.String(), c.wantSubstr) {
+				t.Errorf("Run(%v) output %q does not contain %q", c.args, buf.String(), c.wantSubstr)
+			}
+		})
+	}
+}
+
+func TestRunAppendsToExistingStore(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv("STUDY_TRACKER_HOME", dataDir)
+
+	var buf bytes.Buffer
+	if err := Run([]string{"--user=ada", "--topic=go", "--minutes=30"}, &buf); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if err := Run([]string{"--user=ada", "--topic=go", "--minutes=20"}, &buf); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+
+	sessions, err := store.Load(filepath.Join(dataDir, "ada-sessions.json"))
+	if err != nil {
+		t.Fatalf("store.Load() unexpected error: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("store.Load() returned %d sessions, want 2", len(sessions))
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Run([]string{"-h"}, &buf); err != nil {
+		t.Fatalf("Run([-h]) = %v, want nil error", err)
+	}
+}
@@ -0,0 +1,78 @@
+// Package progress computes per-topic study streaks and weekly totals from
+// logged sessions.
+package progress
+
+import (
+	"sort"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/store"
+)
+
+// TopicStats summarizes one topic's progress as of a given day.
+type TopicStats struct {
+	Topic         string `json:"topic"`
+	StreakDays    int    `json:"streak_days"`
+	WeeklyMinutes int    `json:"weekly_minutes"`
+}
+
+// Compute groups sessions by topic and, relative to asOf, returns each
+// topic's current daily streak and the minutes logged in the trailing
+// 7-day window (asOf's day and the 6 preceding it). Topics are sorted
+// alphabetically.
+func Compute(sessions []store.Session, asOf time.Time) []TopicStats {
+	byTopic := make(map[string][]store.Session)
+	for _, s := range sessions {
+		byTopic[s.Topic] = append(byTopic[s.Topic], s)
+	}
+
+	stats := make([]TopicStats, 0, len(byTopic))
+	for topic, sessions := range byTopic {
+		stats = append(stats, TopicStats{
+			Topic:         topic,
+			StreakDays:    streakDays(sessions, asOf),
+			WeeklyMinutes: weeklyMinutes(sessions, asOf),
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Topic < stats[j].Topic })
+	return stats
+}
+
+// streakDays counts the number of consecutive days, ending at asOf's date
+// and going backwards, that have at least one session.
+func streakDays(sessions []store.Session, asOf time.Time) int {
+	days := make(map[string]bool)
+	for _, s := range sessions {
+		days[dateKey(s.LoggedAt)] = true
+	}
+
+	streak := 0
+	for day := dayStart(asOf); days[dateKey(day)]; day = day.AddDate(0, 0, -1) {
+		streak++
+	}
+	return streak
+}
+
+// weeklyMinutes sums the minutes of sessions logged within the trailing
+// 7-day window ending on asOf's date.
+func weeklyMinutes(sessions []store.Session, asOf time.Time) int {
+	windowStart := dayStart(asOf).AddDate(0, 0, -6)
+	windowEnd := dayStart(asOf).AddDate(0, 0, 1)
+
+	total := 0
+	for _, s := range sessions {
+		if !s.LoggedAt.Before(windowStart) && s.LoggedAt.Before(windowEnd) {
+			total += s.Minutes
+		}
+	}
+	return total
+}
+
+func dayStart(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func dateKey(t time.Time) string {
+	return dayStart(t).Format("2006-01-02")
+}
@@ -0,0 +1,57 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/store"
+)
+
+func at(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+}
+
+func TestCompute(t *testing.T) {
+	asOf := at(2026, time.July, 26)
+	sessions := []store.Session{
+		{Topic: "go", Minutes: 30, LoggedAt: at(2026, time.July, 26)},
+		{Topic: "go", Minutes: 20, LoggedAt: at(2026, time.July, 25)},
+		{Topic: "go", Minutes: 10, LoggedAt: at(2026, time.July, 24)},
+		{Topic: "go", Minutes: 15, LoggedAt: at(2026, time.July, 1)}, // outside the streak and the weekly window
+		{Topic: "system-design", Minutes: 45, LoggedAt: at(2026, time.July, 24)},
+	}
+
+	stats := Compute(sessions, asOf)
+	if len(stats) != 2 {
+		t.Fatalf("Compute() returned %d topics, want 2", len(stats))
+	}
+
+	want := []TopicStats{
+		{Topic: "go", StreakDays: 3, WeeklyMinutes: 60},
+		{Topic: "system-design", StreakDays: 0, WeeklyMinutes: 45},
+	}
+	for i, w := range want {
+		if stats[i] != w {
+			t.Errorf("stats[%d] = %+v, want %+v", i, stats[i], w)
+		}
+	}
+}
+
+func TestComputeNoSessions(t *testing.T) {
+	if stats := Compute(nil, at(2026, time.July, 26)); len(stats) != 0 {
+		t.Errorf("Compute(nil) = %v, want empty", stats)
+	}
+}
+
+func TestStreakBreaksOnGap(t *testing.T) {
+	asOf := at(2026, time.July, 26)
+	sessions := []store.Session{
+		{Topic: "go", Minutes: 30, LoggedAt: at(2026, time.July, 26)},
+		{Topic: "go", Minutes: 30, LoggedAt: at(2026, time.July, 24)}, // gap on the 25th
+	}
+
+	stats := Compute(sessions, asOf)
+	if stats[0].StreakDays != 1 {
+		t.Errorf("StreakDays = %d, want 1", stats[0].StreakDays)
+	}
+}
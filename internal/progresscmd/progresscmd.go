@@ -0,0 +1,58 @@
+// Package progresscmd implements the tracker binary's "progress"
+// subcommand, which reports per-topic streaks and weekly totals.
+package progresscmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/config"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/progress"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/report"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/store"
+)
+
+// Run parses args, computes the current user's progress, and writes it to
+// w as a Markdown table or as JSON.
+func Run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("progress", flag.ContinueOnError)
+	user := fs.String("user", "", "user to report progress for")
+	format := fs.String("format", "markdown", "output format: markdown or json")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	dataDir, err := config.DataDir()
+	if err != nil {
+		return fmt.Errorf("progresscmd: resolving data directory: %w", err)
+	}
+	resolvedUser := config.ResolveUser(*user)
+	path := config.SessionsPath(dataDir, resolvedUser)
+
+	sessions, err := store.Load(path)
+	if err != nil {
+		return err
+	}
+	stats := progress.Compute(sessions, time.Now())
+
+	switch *format {
+	case "markdown":
+		fmt.Fprintf(w, "# Study Progress for %s\n\n", resolvedUser)
+		fmt.Fprint(w, report.RenderMarkdown(stats))
+	case "json":
+		data, err := report.RenderJSON(stats)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(data))
+	default:
+		return fmt.Errorf("unknown format %q (want markdown or json)", *format)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package progresscmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/logcmd"
+)
+
+func TestRun(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv("STUDY_TRACKER_HOME", dataDir)
+
+	var logBuf bytes.Buffer
+	if err := logcmd.Run([]string{"--user=ada", "--topic=go", "--minutes=30"}, &logBuf); err != nil {
+		t.Fatalf("logcmd.Run() unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name       string
+		args       []string
+		wantErr    bool
+		wantSubstr string
+	}{
+		{name: "markdown format", args: []string{"--user=ada", "--format=markdown"}, wantSubstr: "| go | 1 | 30 |"},
+		{name: "json format", args: []string{"--user=ada", "--format=json"}, wantSubstr: `"topic": "go"`},
+		{name: "unknown format errors", args: []string{"--user=ada", "--format=xml"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Run(c.args, &buf)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Run(%v) = nil error, want an error", c.args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run(%v) unexpected error: %v", c.args, err)
+			}
+			if !strings.Contains(buf.String(), c.wantSubstr) {
+				t.Errorf("Run(%v) output %q does not contain %q", c.args, buf.String(), c.wantSubstr)
+			}
+		})
+	}
+}
+
+func TestRunNoSessions(t *testing.T) {
+	t.Setenv("STUDY_TRACKER_HOME", t.TempDir())
+
+	var buf bytes.Buffer
+	if err := Run([]string{"--user=nobody"}, &buf); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No study sessions logged yet.") {
+		t.Errorf("Run() = %q, want the empty-state message", buf.String())
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Run([]string{"-h"}, &buf); err != nil {
+		t.Fatalf("Run([-h]) = %v, want nil error", err)
+	}
+}
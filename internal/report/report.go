@@ -0,0 +1,33 @@
+// Package report renders study progress as Markdown or JSON summaries.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/progress"
+)
+
+// RenderMarkdown renders stats as a "## Progress" section with a table,
+// one row per topic, so callers can nest it under their own top-level
+// heading.
+func RenderMarkdown(stats []progress.TopicStats) string {
+	var b strings.Builder
+	b.WriteString("## Progress\n\n")
+	if len(stats) == 0 {
+		b.WriteString("No study sessions logged yet.\n")
+		return b.String()
+	}
+	b.WriteString("| Topic | Streak (days) | This Week (min) |\n")
+	b.WriteString("| --- | --- | --- |\n")
+	for _, s := range stats {
+		fmt.Fprintf(&b, "| %s | %d | %d |\n", s.Topic, s.StreakDays, s.WeeklyMinutes)
+	}
+	return b.String()
+}
+
+// RenderJSON renders stats as an indented JSON array.
+func RenderJSON(stats []progress.TopicStats) ([]byte, error) {
+	return json.MarshalIndent(stats, "", "  ")
+}
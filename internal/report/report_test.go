@@ -0,0 +1,40 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/progress"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	stats := []progress.TopicStats{
+		{Topic: "go", StreakDays: 3, WeeklyMinutes: 60},
+	}
+	out := RenderMarkdown(stats)
+	for _, want := range []string{"## Progress", "| go | 3 | 60 |"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("RenderMarkdown() = %q, want it to contain %q", out, want)
+		}
+	}
+}
+
+func TestRenderMarkdownEmpty(t *testing.T) {
+	out := RenderMarkdown(nil)
+	if !strings.Contains(out, "No study sessions logged yet.") {
+		t.Errorf("RenderMarkdown(nil) = %q, want the empty-state message", out)
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	stats := []progress.TopicStats{
+		{Topic: "go", StreakDays: 3, WeeklyMinutes: 60},
+	}
+	out, err := RenderJSON(stats)
+	if err != nil {
+		t.Fatalf("RenderJSON() unexpected error: %v", err)
+	}
+	if !strings.Contains(string(out), `"topic": "go"`) {
+		t.Errorf("RenderJSON() = %s, want it to contain the topic field", out)
+	}
+}
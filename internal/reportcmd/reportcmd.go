@@ -0,0 +1,85 @@
+// Package reportcmd implements the tracker binary's "report" subcommand,
+// which renders a full summary: per-topic progress plus the sessions
+// logged so far.
+package reportcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/config"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/progress"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/report"
+	"github.com/abhishek-8285/sde2-study-tracker/internal/store"
+)
+
+// summary bundles per-topic progress with the raw session history for
+// --format=json.
+type summary struct {
+	Stats    []progress.TopicStats `json:"stats"`
+	Sessions []store.Session       `json:"sessions"`
+}
+
+// Run parses args, loads the current user's study history, and writes a
+// full Markdown or JSON report to w.
+func Run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	user := fs.String("user", "", "user to report on")
+	format := fs.String("format", "markdown", "output format: markdown or json")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	dataDir, err := config.DataDir()
+	if err != nil {
+		return fmt.Errorf("reportcmd: resolving data directory: %w", err)
+	}
+	resolvedUser := config.ResolveUser(*user)
+	path := config.SessionsPath(dataDir, resolvedUser)
+
+	sessions, err := store.Load(path)
+	if err != nil {
+		return err
+	}
+	stats := progress.Compute(sessions, time.Now())
+
+	switch *format {
+	case "markdown":
+		fmt.Fprint(w, renderMarkdown(resolvedUser, stats, sessions))
+	case "json":
+		data, err := json.MarshalIndent(summary{Stats: stats, Sessions: sessions}, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(data))
+	default:
+		return fmt.Errorf("unknown format %q (want markdown or json)", *format)
+	}
+	return nil
+}
+
+func renderMarkdown(user string, stats []progress.TopicStats, sessions []store.Session) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Study Report for %s\n\n", user)
+	b.WriteString(report.RenderMarkdown(stats))
+
+	b.WriteString("\n## Sessions\n\n")
+	if len(sessions) == 0 {
+		b.WriteString("No study sessions logged yet.\n")
+		return b.String()
+	}
+	b.WriteString("| Date | Topic | Minutes | Notes |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+	for _, s := range sessions {
+		fmt.Fprintf(&b, "| %s | %s | %d | %s |\n", s.LoggedAt.Format("2006-01-02"), s.Topic, s.Minutes, s.Notes)
+	}
+	return b.String()
+}
@@ -0,0 +1,85 @@
+package reportcmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/abhishek-8285/sde2-study-tracker/internal/logcmd"
+)
+
+func TestRun(t *testing.T) {
+	dataDir := t.TempDir()
+	t.Setenv("STUDY_TRACKER_HOME", dataDir)
+
+	var logBuf bytes.Buffer
+	if err := logcmd.Run([]string{"--user=ada", "--topic=go", "--minutes=30", "--notes=channels"}, &logBuf); err != nil {
+		t.Fatalf("logcmd.Run() unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		name       string
+		args       []string
+		wantErr    bool
+		wantSubstr []string
+	}{
+		{name: "markdown format", args: []string{"--user=ada", "--format=markdown"}, wantSubstr: []string{"# Study Report for ada", "## Progress", "## Sessions", "channels"}},
+		{name: "json format", args: []string{"--user=ada", "--format=json"}, wantSubstr: []string{`"stats"`, `"sessions"`, `"notes": "channels"`}},
+		{name: "unknown format errors", args: []string{"--user=ada", "--format=xml"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Run(c.args, &buf)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Run(%v) = nil error, want an error", c.args)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run(%v) unexpected error: %v", c.args, err)
+			}
+			for _, want := range c.wantSubstr {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("Run(%v) output %q does not contain %q", c.args, buf.String(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestRunMarkdownHasSingleTopLevelHeading(t *testing.T) {
+	t.Setenv("STUDY_TRACKER_HOME", t.TempDir())
+
+	var buf bytes.Buffer
+	if err := Run([]string{"--user=ada", "--format=markdown"}, &buf); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if n := strings.Count(buf.String(), "\n# "); n != 0 {
+		t.Errorf("Run() output has %d nested top-level headings, want 0:\n%s", n, buf.String())
+	}
+	if !strings.HasPrefix(buf.String(), "# Study Report for ada") {
+		t.Errorf("Run() output = %q, want it to start with the report's own heading", buf.String())
+	}
+}
+
+func TestRunNoSessions(t *testing.T) {
+	t.Setenv("STUDY_TRACKER_HOME", t.TempDir())
+
+	var buf bytes.Buffer
+	if err := Run([]string{"--user=nobody"}, &buf); err != nil {
+		t.Fatalf("Run() unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No study sessions logged yet.") {
+		t.Errorf("Run() = %q, want the empty-state message", buf.String())
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Run([]string{"-h"}, &buf); err != nil {
+		t.Fatalf("Run([-h]) = %v, want nil error", err)
+	}
+}
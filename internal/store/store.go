@@ -0,0 +1,58 @@
+// Package store persists study sessions to a JSON file on disk.
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Session records a single study session logged by the user.
+type Session struct {
+	Topic    string    `json:"topic"`
+	Minutes  int       `json:"minutes"`
+	Notes    string    `json:"notes"`
+	LoggedAt time.Time `json:"logged_at"`
+}
+
+// Load reads all sessions from path, ordered oldest first. A missing file
+// is treated as an empty store rather than an error.
+func Load(path string) ([]Session, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("store: reading %s: %w", path, err)
+	}
+	var sessions []Session
+	if err := json.Unmarshal(data, &sessions); err != nil {
+		return nil, fmt.Errorf("store: parsing %s: %w", path, err)
+	}
+	return sessions, nil
+}
+
+// Append loads the sessions at path, adds s, and writes the result back.
+// It creates path's parent directory and the file itself if they don't
+// yet exist.
+func Append(path string, s Session) error {
+	sessions, err := Load(path)
+	if err != nil {
+		return err
+	}
+	sessions = append(sessions, s)
+
+	data, err := json.MarshalIndent(sessions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("store: encoding %s: %w", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("store: creating %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("store: writing %s: %w", path, err)
+	}
+	return nil
+}
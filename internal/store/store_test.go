@@ -0,0 +1,41 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	sessions, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(sessions) != 0 {
+		t.Errorf("Load() = %v, want empty", sessions)
+	}
+}
+
+func TestAppendAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.json")
+	first := Session{Topic: "go", Minutes: 30, Notes: "channels", LoggedAt: time.Date(2026, 7, 20, 9, 0, 0, 0, time.UTC)}
+	second := Session{Topic: "system-design", Minutes: 45, Notes: "caching", LoggedAt: time.Date(2026, 7, 21, 9, 0, 0, 0, time.UTC)}
+
+	if err := Append(path, first); err != nil {
+		t.Fatalf("Append() unexpected error: %v", err)
+	}
+	if err := Append(path, second); err != nil {
+		t.Fatalf("Append() unexpected error: %v", err)
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("Load() returned %d sessions, want 2", len(got))
+	}
+	if got[0] != first || got[1] != second {
+		t.Errorf("Load() = %+v, want [%+v %+v]", got, first, second)
+	}
+}
@@ -0,0 +1,85 @@
+package greet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds how long an ExternalGreeter will wait for the
+// external program to exit when Timeout is zero.
+const DefaultTimeout = 5 * time.Second
+
+// ExternalGreeter shells out to an external program to render a greeting.
+// The name is passed on the program's stdin rather than interpolated into
+// a command string, so callers never need to build or escape a shell
+// command line themselves.
+type ExternalGreeter struct {
+	// Path is the program to run, resolved via exec.LookPath semantics.
+	Path string
+	// Args is the literal argv passed to the program, not including Path.
+	// Unlike a shell command string, each element is passed to the
+	// process as-is with no further splitting or expansion.
+	Args []string
+	// Timeout bounds how long to wait for the program to exit. Defaults
+	// to DefaultTimeout when zero.
+	Timeout time.Duration
+	// Env holds additional "KEY=VALUE" entries to expose to the program,
+	// on top of the minimal scrubbed base environment. Nil by default,
+	// meaning the program inherits no environment from this process.
+	Env []string
+}
+
+// ExitError reports that an external greeter program exited with a
+// non-zero status.
+type ExitError struct {
+	Path   string
+	Code   int
+	Stderr string
+}
+
+func (e *ExitError) Error() string {
+	msg := fmt.Sprintf("greet: %s exited with code %d", e.Path, e.Code)
+	if e.Stderr != "" {
+		msg += ": " + e.Stderr
+	}
+	return msg
+}
+
+// Greet implements Greeter by running the configured program with name on
+// its stdin and the trimmed contents of its stdout as the greeting.
+func (g *ExternalGreeter) Greet(ctx context.Context, name string) (string, error) {
+	timeout := g.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, g.Path, g.Args...)
+	cmd.Stdin = strings.NewReader(name)
+	// A nil Env would make exec.Cmd fall back to inheriting this
+	// process's full environment, defeating the scrubbing the Env doc
+	// comment promises. An empty-but-non-nil slice keeps "no Env set"
+	// meaning "no environment" instead.
+	cmd.Env = append([]string{}, g.Env...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		return "", fmt.Errorf("greet: %s timed out after %s", g.Path, timeout)
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return "", &ExitError{Path: g.Path, Code: exitErr.ExitCode(), Stderr: strings.TrimSpace(stderr.String())}
+	}
+	if err != nil {
+		return "", fmt.Errorf("greet: running %s: %w", g.Path, err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
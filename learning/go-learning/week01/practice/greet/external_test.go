@@ -0,0 +1,103 @@
+package greet
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// buildHelper compiles the test helper program under
+// testdata/<pkg> and returns the path to the resulting binary.
+func buildHelper(t *testing.T, pkg string) string {
+	t.Helper()
+	bin := filepath.Join(t.TempDir(), pkg)
+	cmd := exec.Command("go", "build", "-o", bin, "./testdata/"+pkg)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("building helper %s: %v\n%s", pkg, err, out)
+	}
+	return bin
+}
+
+func TestExternalGreeterSuccess(t *testing.T) {
+	bin := buildHelper(t, "echoname")
+	g := &ExternalGreeter{Path: bin}
+
+	got, err := g.Greet(context.Background(), "Ada")
+	if err != nil {
+		t.Fatalf("Greet() unexpected error: %v", err)
+	}
+	want := "Hello, Ada (from echoname)!"
+	if got != want {
+		t.Errorf("Greet() = %q, want %q", got, want)
+	}
+}
+
+func TestExternalGreeterExitError(t *testing.T) {
+	bin := buildHelper(t, "failgreeter")
+	g := &ExternalGreeter{Path: bin}
+
+	_, err := g.Greet(context.Background(), "Ada")
+	if err == nil {
+		t.Fatal("Greet() = nil error, want an ExitError")
+	}
+	exitErr, ok := err.(*ExitError)
+	if !ok {
+		t.Fatalf("Greet() error type = %T, want *ExitError", err)
+	}
+	if exitErr.Code != 7 {
+		t.Errorf("exitErr.Code = %d, want 7", exitErr.Code)
+	}
+	if !strings.Contains(exitErr.Stderr, "refusing to greet") {
+		t.Errorf("exitErr.Stderr = %q, want it to contain the helper's message", exitErr.Stderr)
+	}
+}
+
+func TestExternalGreeterScrubsParentEnv(t *testing.T) {
+	t.Setenv("SUPER_SECRET", "shh123")
+	bin := buildHelper(t, "envdump")
+	g := &ExternalGreeter{Path: bin}
+
+	got, err := g.Greet(context.Background(), "Ada")
+	if err != nil {
+		t.Fatalf("Greet() unexpected error: %v", err)
+	}
+	if strings.Contains(got, "shh123") {
+		t.Errorf("Greet() output %q leaked the parent's SUPER_SECRET env var", got)
+	}
+	if got != "" {
+		t.Errorf("Greet() = %q, want an empty environment dump", got)
+	}
+}
+
+func TestExternalGreeterPassesExplicitEnv(t *testing.T) {
+	t.Setenv("SUPER_SECRET", "shh123")
+	bin := buildHelper(t, "envdump")
+	g := &ExternalGreeter{Path: bin, Env: []string{"GREETING_LOCALE=fr"}}
+
+	got, err := g.Greet(context.Background(), "Ada")
+	if err != nil {
+		t.Fatalf("Greet() unexpected error: %v", err)
+	}
+	if strings.Contains(got, "shh123") {
+		t.Errorf("Greet() output %q leaked the parent's SUPER_SECRET env var", got)
+	}
+	if !strings.Contains(got, "GREETING_LOCALE=fr") {
+		t.Errorf("Greet() output %q is missing the explicitly configured env entry", got)
+	}
+}
+
+func TestExternalGreeterTimeout(t *testing.T) {
+	bin := buildHelper(t, "echoname")
+	g := &ExternalGreeter{Path: bin, Timeout: time.Nanosecond}
+
+	_, err := g.Greet(context.Background(), "Ada")
+	if err == nil {
+		t.Fatal("Greet() = nil error, want a timeout error")
+	}
+	if !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Greet() error = %q, want it to mention timing out", err)
+	}
+}
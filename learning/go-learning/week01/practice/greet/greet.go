@@ -0,0 +1,49 @@
+// Package greet provides pluggable backends for producing a greeting for a
+// given name. A Greeter can render the message locally or delegate to an
+// external program.
+package greet
+
+import (
+	"context"
+	"fmt"
+)
+
+// Greeter renders a greeting for name.
+type Greeter interface {
+	Greet(ctx context.Context, name string) (string, error)
+}
+
+// greetings maps a language code to its greeting template, where "%s" is
+// replaced by the name. English is the fallback for unknown codes.
+var greetings = map[string]string{
+	"en": "Hello, %s!",
+	"es": "¡Hola, %s!",
+	"fr": "Bonjour, %s!",
+	"de": "Hallo, %s!",
+	"hi": "नमस्ते, %s!",
+	"ja": "こんにちは、%sさん!",
+}
+
+// DefaultLang is used when Lang is empty or unrecognized.
+const DefaultLang = "en"
+
+// LocalGreeter renders a greeting in-process from the built-in
+// translation table.
+type LocalGreeter struct {
+	Lang string
+}
+
+// NewLocalGreeter returns a LocalGreeter for the given language code.
+// An empty or unrecognized code falls back to DefaultLang.
+func NewLocalGreeter(lang string) *LocalGreeter {
+	return &LocalGreeter{Lang: lang}
+}
+
+// Greet implements Greeter.
+func (g *LocalGreeter) Greet(ctx context.Context, name string) (string, error) {
+	template, ok := greetings[g.Lang]
+	if !ok {
+		template = greetings[DefaultLang]
+	}
+	return fmt.Sprintf(template, name), nil
+}
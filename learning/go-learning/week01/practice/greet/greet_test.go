@@ -0,0 +1,31 @@
+package greet
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLocalGreeter(t *testing.T) {
+	cases := []struct {
+		lang string
+		want string
+	}{
+		{lang: "en", want: "Hello, Ada!"},
+		{lang: "fr", want: "Bonjour, Ada!"},
+		{lang: "xx", want: "Hello, Ada!"},
+		{lang: "", want: "Hello, Ada!"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.lang, func(t *testing.T) {
+			g := NewLocalGreeter(c.lang)
+			got, err := g.Greet(context.Background(), "Ada")
+			if err != nil {
+				t.Fatalf("Greet() unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("Greet() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
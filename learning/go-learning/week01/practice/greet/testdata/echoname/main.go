@@ -0,0 +1,20 @@
+// Command echoname is a test helper for greet.ExternalGreeter: it reads a
+// name from stdin and writes "Hello, <name> (from echoname)!" to stdout.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "echoname: reading stdin:", err)
+		os.Exit(1)
+	}
+	name := strings.TrimSpace(string(data))
+	fmt.Printf("Hello, %s (from echoname)!\n", name)
+}
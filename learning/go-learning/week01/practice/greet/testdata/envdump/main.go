@@ -0,0 +1,14 @@
+// Command envdump is a test helper for greet.ExternalGreeter: it writes
+// its own environment, one "KEY=VALUE" entry per line, to stdout.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	for _, kv := range os.Environ() {
+		fmt.Println(kv)
+	}
+}
@@ -0,0 +1,13 @@
+// Command failgreeter is a test helper for greet.ExternalGreeter: it always
+// writes a message to stderr and exits non-zero.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	fmt.Fprintln(os.Stderr, "failgreeter: refusing to greet anyone")
+	os.Exit(7)
+}
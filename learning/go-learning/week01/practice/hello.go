@@ -1,26 +1,133 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/abhishek-8285/sde2-study-tracker/learning/go-learning/week01/practice/greet"
 )
 
+// result is the structured payload emitted for --format=json and --format=yaml.
+type result struct {
+	Name    string `json:"name"`
+	Lang    string `json:"lang"`
+	Message string `json:"message"`
+}
+
 func main() {
-	// Basic Hello World
-	fmt.Println("Hello, Go World!")
+	name := flag.String("name", "", "name to greet")
+	lang := flag.String("lang", "", "language code for the greeting (en, es, fr, de, hi, ja)")
+	format := flag.String("format", "text", "output format: text, json, or yaml")
+	repeat := flag.Int("repeat", 1, "number of times to repeat the greeting")
+	externalCmd := flag.String("external-cmd", "", "path to an external program to render the greeting; the name is passed on its stdin")
+	externalArgs := flag.String("external-args", "", "comma-separated argv to pass to --external-cmd")
+	externalTimeout := flag.Duration("external-timeout", greet.DefaultTimeout, "timeout for --external-cmd")
+	flag.Parse()
+
+	resolvedName := resolveName(*name, flag.Args())
+	resolvedLang := resolveLang(*lang)
+
+	g := newGreeter(resolvedLang, *externalCmd, *externalArgs, *externalTimeout)
+
+	if err := run(context.Background(), os.Stdout, g, resolvedName, resolvedLang, *format, *repeat); err != nil {
+		fmt.Fprintln(os.Stderr, "hello:", err)
+		os.Exit(1)
+	}
+}
+
+// newGreeter builds a greet.Greeter: an ExternalGreeter when externalCmd is
+// set, or a LocalGreeter for lang otherwise.
+func newGreeter(lang, externalCmd, externalArgs string, timeout time.Duration) greet.Greeter {
+	if externalCmd == "" {
+		return greet.NewLocalGreeter(lang)
+	}
+	var args []string
+	if externalArgs != "" {
+		args = strings.Split(externalArgs, ",")
+	}
+	return &greet.ExternalGreeter{Path: externalCmd, Args: args, Timeout: timeout}
+}
+
+// resolveName picks the greeted name using the following precedence:
+//  1. the --name flag
+//  2. the first positional argument (os.Args)
+//  3. the USER, LOGNAME, then USERNAME environment variables, in that order
+//  4. "World" if none of the above are set
+func resolveName(nameFlag string, args []string) string {
+	if nameFlag != "" {
+		return nameFlag
+	}
+	if len(args) > 0 && args[0] != "" {
+		return args[0]
+	}
+	for _, envVar := range []string{"USER", "LOGNAME", "USERNAME"} {
+		if v := os.Getenv(envVar); v != "" {
+			return v
+		}
+	}
+	return "World"
+}
 
-	// Hello with command line arguments
-	if len(os.Args) > 1 {
-		fmt.Printf("Hello, %s!\n", os.Args[1])
+// resolveLang picks the greeting language using the --lang flag, falling
+// back to the LANG environment variable (trimmed to its leading locale
+// component, e.g. "fr_FR.UTF-8" -> "fr"), then greet.DefaultLang.
+func resolveLang(langFlag string) string {
+	if langFlag != "" {
+		return langFlag
+	}
+	if v := os.Getenv("LANG"); v != "" {
+		code := strings.SplitN(v, "_", 2)[0]
+		code = strings.SplitN(code, ".", 2)[0]
+		if code != "" {
+			return code
+		}
+	}
+	return greet.DefaultLang
+}
+
+// run renders repeat copies of g's greeting for name to w in the given
+// format. It returns an error for an unknown format, a non-positive
+// repeat, or a failure from the greeter itself.
+func run(ctx context.Context, w io.Writer, g greet.Greeter, name, lang, format string, repeat int) error {
+	if repeat < 1 {
+		return fmt.Errorf("--repeat must be at least 1, got %d", repeat)
+	}
+	msg, err := g.Greet(ctx, name)
+	if err != nil {
+		return err
 	}
 
-	// Hello with environment variable
-	if name := os.Getenv("USER"); name != "" {
-		fmt.Printf("Hello, %s (from environment)!\n", name)
+	switch format {
+	case "text":
+		for i := 0; i < repeat; i++ {
+			fmt.Fprintln(w, msg)
+		}
+	case "json":
+		enc := json.NewEncoder(w)
+		for i := 0; i < repeat; i++ {
+			if err := enc.Encode(result{Name: name, Lang: lang, Message: msg}); err != nil {
+				return err
+			}
+		}
+	case "yaml":
+		for i := 0; i < repeat; i++ {
+			fmt.Fprintf(w, "name: %s\nlang: %s\nmessage: %s\n---\n", name, lang, msg)
+		}
+	default:
+		return fmt.Errorf("unknown format %q (want text, json, or yaml)", format)
 	}
+	return nil
 }
 
 // To run this program:
 // go run hello.go
 // go run hello.go "Your Name"
+// go run hello.go --name="Your Name" --lang=fr --format=json --repeat=2
+// go run hello.go --external-cmd=/path/to/greeter
 // USER=TestUser go run hello.go
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/abhishek-8285/sde2-study-tracker/learning/go-learning/week01/practice/greet"
+)
+
+// fixture describes one testscript-style scenario: the inputs a user could
+// plausibly supply (flag, positional arg, or env var) and what the rendered
+// output must contain.
+type fixture struct {
+	name       string
+	nameFlag   string
+	args       []string
+	env        map[string]string
+	wantName   string
+	wantSubstr string
+}
+
+func TestResolveName(t *testing.T) {
+	fixtures := []fixture{
+		{name: "flag wins over everything", nameFlag: "Ada", args: []string{"Grace"}, env: map[string]string{"USER": "root"}, wantName: "Ada"},
+		{name: "positional arg wins over env", args: []string{"Grace"}, env: map[string]string{"USER": "root"}, wantName: "Grace"},
+		{name: "USER wins over LOGNAME and USERNAME", env: map[string]string{"USER": "alice", "LOGNAME": "bob", "USERNAME": "carol"}, wantName: "alice"},
+		{name: "LOGNAME used when USER unset", env: map[string]string{"LOGNAME": "bob", "USERNAME": "carol"}, wantName: "bob"},
+		{name: "USERNAME used when USER and LOGNAME unset", env: map[string]string{"USERNAME": "carol"}, wantName: "carol"},
+		{name: "falls back to World", wantName: "World"},
+	}
+
+	for _, f := range fixtures {
+		t.Run(f.name, func(t *testing.T) {
+			for k, v := range f.env {
+				t.Setenv(k, v)
+			}
+			got := resolveName(f.nameFlag, f.args)
+			if got != f.wantName {
+				t.Errorf("resolveName(%q, %v) = %q, want %q", f.nameFlag, f.args, got, f.wantName)
+			}
+		})
+	}
+}
+
+func TestResolveLang(t *testing.T) {
+	cases := []struct {
+		name     string
+		langFlag string
+		env      string
+		want     string
+	}{
+		{name: "flag wins over LANG", langFlag: "fr", env: "de_DE.UTF-8", want: "fr"},
+		{name: "LANG env is parsed to its locale prefix", env: "es_ES.UTF-8", want: "es"},
+		{name: "bare LANG code is used as-is", env: "ja", want: "ja"},
+		{name: "falls back to default", want: greet.DefaultLang},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Setenv("LANG", c.env)
+			got := resolveLang(c.langFlag)
+			if got != c.want {
+				t.Errorf("resolveLang(%q) with LANG=%q = %q, want %q", c.langFlag, c.env, got, c.want)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	cases := []struct {
+		name       string
+		format     string
+		repeat     int
+		wantErr    bool
+		wantSubstr string
+		wantLines  int
+	}{
+		{name: "text format", format: "text", repeat: 2, wantSubstr: "Hello, Ada!", wantLines: 2},
+		{name: "json format", format: "json", repeat: 1, wantSubstr: `"message":"Hello, Ada!"`, wantLines: 1},
+		{name: "yaml format", format: "yaml", repeat: 1, wantSubstr: "message: Hello, Ada!", wantLines: 2},
+		{name: "unknown format errors", format: "xml", repeat: 1, wantErr: true},
+		{name: "non-positive repeat errors", format: "text", repeat: 0, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := run(context.Background(), &buf, greet.NewLocalGreeter("en"), "Ada", "en", c.format, c.repeat)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("run() = nil error, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("run() unexpected error: %v", err)
+			}
+			out := buf.String()
+			if !strings.Contains(out, c.wantSubstr) {
+				t.Errorf("run() output %q does not contain %q", out, c.wantSubstr)
+			}
+			if got := strings.Count(out, "\n"); got < c.wantLines {
+				t.Errorf("run() produced %d lines, want at least %d", got, c.wantLines)
+			}
+		})
+	}
+}